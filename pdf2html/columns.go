@@ -0,0 +1,240 @@
+package pdf2html
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DetectColumnsOption configures automatic column detection for a page area.
+type DetectColumnsOption struct {
+	From, To int      // In what vertical area should the columns be detected
+	Columns  []string // CSS-grid-like column spec, left to right: "auto", "*" / "<n>fr", or a fixed pixel width (e.g. "120")
+}
+
+type columnTrackKind int
+
+const (
+	columnTrackAuto columnTrackKind = iota
+	columnTrackFr
+	columnTrackFixed
+)
+
+type columnTrack struct {
+	kind   columnTrackKind
+	weight float64 // fr weight, unused for auto/fixed
+	width  int     // resolved width, only set for fixed
+}
+
+// DetectColumns clusters the Left position of texts inside [From,To] into columns and
+// returns ranges usable by GetColumnCalculationInRanges, so callers don't have to measure
+// pixel positions by hand. Fixed pixel widths pin an exact range. The "auto" columns together
+// take the whole span left between the leading and trailing fixed columns, split at the
+// midpoints between weighted cluster centers of the observed Left values; they are not narrowed
+// to the extent of their clusters. "fr" columns split proportionally whatever width the fixed and
+// auto columns leave, so whenever the spec holds an "auto" column and texts lie inside
+// [From,To], the "fr" columns get zero width. Columns are laid out left to right starting at page.Left.
+func DetectColumns(page PdfXmlPage, opt DetectColumnsOption) []GetColumnCalculationInRangesOption {
+	tracks := parseColumnTracks(opt.Columns)
+
+	autoCount, fixedWidth, frWeight := 0, 0, 0.0
+	leadingFixed, trailingFixed, seenAuto := 0, 0, false
+	for _, track := range tracks {
+		switch track.kind {
+		case columnTrackAuto:
+			autoCount++
+			seenAuto = true
+		case columnTrackFixed:
+			fixedWidth += track.width
+			if seenAuto {
+				trailingFixed += track.width
+			} else {
+				leadingFixed += track.width
+			}
+		case columnTrackFr:
+			frWeight += track.weight
+		}
+	}
+
+	// Auto columns only cluster over the span not already pinned by a fixed column before or
+	// after them, otherwise their widths overlap whatever those fixed columns claimed.
+	lefts := collectWeightedLefts(page, opt.From, opt.To)
+	centers := kMeansLefts(lefts, autoCount)
+	autoWidths := clusterWidths(centers, *page.Left+leadingFixed, *page.Left+*page.Width-trailingFixed)
+
+	autoWidthSum := 0
+	for _, width := range autoWidths {
+		autoWidthSum += width
+	}
+
+	remaining := *page.Width - fixedWidth - autoWidthSum
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	ranges := make([]GetColumnCalculationInRangesOption, 0, len(tracks))
+	cursor, autoIndex := *page.Left, 0
+	for _, track := range tracks {
+		width := 0
+
+		switch track.kind {
+		case columnTrackFixed:
+			width = track.width
+		case columnTrackAuto:
+			width = autoWidths[autoIndex]
+			autoIndex++
+		case columnTrackFr:
+			if frWeight > 0 {
+				width = int(float64(remaining) * (track.weight / frWeight))
+			}
+		}
+
+		ranges = append(ranges, GetColumnCalculationInRangesOption{From: cursor, To: cursor + width})
+		cursor += width
+	}
+
+	return ranges
+}
+
+// parseColumnTracks turns a CSS-grid-like column spec into its typed representation.
+// A spec that is neither "auto", "*", "<n>fr" nor a plain integer is treated as "auto".
+func parseColumnTracks(specs []string) []columnTrack {
+	tracks := make([]columnTrack, len(specs))
+
+	for i, spec := range specs {
+		spec = strings.TrimSpace(spec)
+
+		switch {
+		case spec == "auto":
+			tracks[i] = columnTrack{kind: columnTrackAuto}
+		case spec == "*":
+			tracks[i] = columnTrack{kind: columnTrackFr, weight: 1}
+		case strings.HasSuffix(spec, "fr"):
+			weight, err := strconv.ParseFloat(strings.TrimSuffix(spec, "fr"), 64)
+			if err != nil || weight <= 0 {
+				tracks[i] = columnTrack{kind: columnTrackAuto}
+				continue
+			}
+
+			tracks[i] = columnTrack{kind: columnTrackFr, weight: weight}
+		default:
+			width, err := strconv.Atoi(spec)
+			if err != nil {
+				tracks[i] = columnTrack{kind: columnTrackAuto}
+				continue
+			}
+
+			tracks[i] = columnTrack{kind: columnTrackFixed, width: width}
+		}
+	}
+
+	return tracks
+}
+
+type weightedLeft struct {
+	left   int
+	weight int
+}
+
+// collectWeightedLefts builds a frequency histogram of text.Left inside [from,to], used to
+// seed and run the "auto" column clustering.
+func collectWeightedLefts(page PdfXmlPage, from, to int) []weightedLeft {
+	counts := map[int]int{}
+
+	for _, text := range page.Texts {
+		if *text.Top < from || *text.Top > to {
+			continue
+		}
+
+		counts[*text.Left]++
+	}
+
+	lefts := make([]weightedLeft, 0, len(counts))
+	for left, weight := range counts {
+		lefts = append(lefts, weightedLeft{left: left, weight: weight})
+	}
+
+	sort.Slice(lefts, func(i, j int) bool { return lefts[i].left < lefts[j].left })
+
+	return lefts
+}
+
+// kMeansLefts runs a small weighted 1-D k-means pass over the observed Left values to find k
+// cluster centers, seeding centroids evenly across the observed range.
+func kMeansLefts(lefts []weightedLeft, k int) []int {
+	if k <= 0 || len(lefts) == 0 {
+		return []int{}
+	}
+
+	min, max := lefts[0].left, lefts[len(lefts)-1].left
+	centroids := make([]float64, k)
+	for i := range centroids {
+		if k == 1 {
+			centroids[i] = float64(min+max) / 2
+			continue
+		}
+
+		centroids[i] = float64(min) + float64(max-min)*float64(i)/float64(k-1)
+	}
+
+	const iterations = 10
+	for iter := 0; iter < iterations; iter++ {
+		sums := make([]float64, k)
+		weights := make([]int, k)
+
+		for _, point := range lefts {
+			nearest := 0
+			nearestDistance := -1.0
+			for i, centroid := range centroids {
+				distance := centroid - float64(point.left)
+				if distance < 0 {
+					distance = -distance
+				}
+				if nearestDistance < 0 || distance < nearestDistance {
+					nearest, nearestDistance = i, distance
+				}
+			}
+
+			sums[nearest] += float64(point.left * point.weight)
+			weights[nearest] += point.weight
+		}
+
+		for i := range centroids {
+			if weights[i] > 0 {
+				centroids[i] = sums[i] / float64(weights[i])
+			}
+		}
+	}
+
+	result := make([]int, k)
+	for i, centroid := range centroids {
+		result[i] = int(centroid)
+	}
+
+	sort.Ints(result)
+
+	return result
+}
+
+// clusterWidths turns sorted cluster centers into contiguous widths spanning [rangeFrom,rangeTo],
+// splitting at the midpoint between each pair of neighbouring centers.
+func clusterWidths(centers []int, rangeFrom, rangeTo int) []int {
+	if len(centers) == 0 {
+		return []int{}
+	}
+
+	boundaries := make([]int, len(centers)+1)
+	boundaries[0] = rangeFrom
+	boundaries[len(centers)] = rangeTo
+
+	for i := 0; i < len(centers)-1; i++ {
+		boundaries[i+1] = (centers[i] + centers[i+1]) / 2
+	}
+
+	widths := make([]int, len(centers))
+	for i := range centers {
+		widths[i] = boundaries[i+1] - boundaries[i]
+	}
+
+	return widths
+}
@@ -0,0 +1,49 @@
+package pdf2html
+
+import "testing"
+
+func TestDetectColumnsAutoSpanExcludesFixedTrackWidth(t *testing.T) {
+	page := PdfXmlPage{
+		Top: intPtr(0), Left: intPtr(0), Width: intPtr(300), Height: intPtr(400),
+		Texts: []PdfXmlText{
+			newText(10, 100, 20, 10, "a"),
+			newText(30, 100, 20, 10, "a2"),
+			newText(10, 200, 20, 10, "b"),
+			newText(30, 200, 20, 10, "b2"),
+		},
+	}
+
+	ranges := DetectColumns(page, DetectColumnsOption{
+		From: 0, To: 100,
+		Columns: []string{"80", "auto", "auto"},
+	})
+
+	if len(ranges) != 3 {
+		t.Fatalf("expected 3 ranges, got %d", len(ranges))
+	}
+
+	for i := 1; i < len(ranges); i++ {
+		if ranges[i].From < ranges[i-1].To {
+			t.Fatalf("ranges overlap: %+v", ranges)
+		}
+	}
+
+	last := ranges[len(ranges)-1]
+	if last.To > *page.Left+*page.Width {
+		t.Fatalf("last range %+v runs past the page edge %d", last, *page.Left+*page.Width)
+	}
+
+	// The two observed clusters (Left=100 and Left=200) must land in different auto columns.
+	columnOf := func(left int) int {
+		for i, r := range ranges {
+			if left >= r.From && left <= r.To {
+				return i
+			}
+		}
+		return -1
+	}
+
+	if columnOf(100) == columnOf(200) {
+		t.Fatalf("expected clusters at 100 and 200 in different columns, got ranges %+v", ranges)
+	}
+}
@@ -0,0 +1,143 @@
+package pdf2html
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+// Decoder streams a pdftohtml XML export page by page instead of unmarshaling the whole
+// document into memory, which matters once a PDF runs into the thousands of pages. It is
+// modelled on the row-streaming pattern of excelize's Rows.Next()/GetRowOpts.
+type Decoder struct {
+	decoder *xml.Decoder
+
+	producer *string
+	version  *string
+	outlines []PdfXmlOutline
+
+	rootRead bool
+	done     bool
+}
+
+// NewDecoder wraps r in a Decoder that yields one PdfXmlPage at a time via Next.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{decoder: xml.NewDecoder(r)}
+}
+
+// Next returns the next page in the document, or io.EOF once the document is exhausted.
+// pdftohtml emits the outline after the pages, so Outlines only reflects the complete outline
+// once Next has returned io.EOF.
+func (d *Decoder) Next() (PdfXmlPage, error) {
+	if d.done {
+		return PdfXmlPage{}, io.EOF
+	}
+
+	if !d.rootRead {
+		if err := d.readRoot(); err != nil {
+			d.done = true
+			return PdfXmlPage{}, err
+		}
+	}
+
+	for {
+		token, err := d.decoder.Token()
+		if err == io.EOF {
+			d.done = true
+			return PdfXmlPage{}, io.EOF
+		}
+		if err != nil {
+			d.done = true
+			return PdfXmlPage{}, err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "page":
+			var page PdfXmlPage
+			if err := d.decoder.DecodeElement(&page, &start); err != nil {
+				d.done = true
+				return PdfXmlPage{}, err
+			}
+
+			return page, nil
+		case "outline":
+			var outline PdfXmlOutline
+			if err := d.decoder.DecodeElement(&outline, &start); err != nil {
+				d.done = true
+				return PdfXmlPage{}, err
+			}
+
+			d.outlines = append(d.outlines, outline)
+		}
+	}
+}
+
+// readRoot consumes tokens up to and including the <pdf2xml> start element, capturing its
+// producer/version attributes.
+func (d *Decoder) readRoot() error {
+	for {
+		token, err := d.decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "pdf2xml" {
+			continue
+		}
+
+		for _, attr := range start.Attr {
+			value := attr.Value
+
+			switch attr.Name.Local {
+			case "producer":
+				d.producer = &value
+			case "version":
+				d.version = &value
+			}
+		}
+
+		d.rootRead = true
+		return nil
+	}
+}
+
+// Producer returns the pdftohtml producer attribute, if any.
+func (d *Decoder) Producer() *string {
+	return d.producer
+}
+
+// Version returns the pdftohtml version attribute, if any.
+func (d *Decoder) Version() *string {
+	return d.version
+}
+
+// Outlines returns the outline items decoded so far. Call this once Next has returned io.EOF
+// to get the complete document outline.
+func (d *Decoder) Outlines() []PdfXmlOutline {
+	return d.outlines
+}
+
+// StreamPages decodes r page by page, invoking fn for each one without ever holding the whole
+// document in memory. It stops and returns fn's error as soon as fn returns a non-nil error.
+func StreamPages(r io.Reader, fn func(PdfXmlPage) error) error {
+	decoder := NewDecoder(r)
+
+	for {
+		page, err := decoder.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := fn(page); err != nil {
+			return err
+		}
+	}
+}
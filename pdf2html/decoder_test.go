@@ -0,0 +1,72 @@
+package pdf2html
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+const decoderTestDoc = `<?xml version="1.0" encoding="UTF-8"?>
+<pdf2xml producer="poppler" version="1.0">
+<page number="1" position="Top" top="0" left="0" width="600" height="800">
+<fontspec id="0" size="10" family="Arial" color="#000000"/>
+<text top="10" left="10" width="50" height="12" font="0">Hello</text>
+</page>
+<page number="2" position="Top" top="0" left="0" width="600" height="800">
+<text top="10" left="10" width="50" height="12">Second</text>
+</page>
+<outline>
+<item page="1">Chapter 1</item>
+</outline>
+</pdf2xml>`
+
+func TestDecoderNextYieldsPagesThenEOF(t *testing.T) {
+	decoder := NewDecoder(strings.NewReader(decoderTestDoc))
+
+	page, err := decoder.Next()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *page.PageNumber != 1 || len(page.Texts) != 1 {
+		t.Fatalf("unexpected first page: %+v", page)
+	}
+
+	page, err = decoder.Next()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *page.PageNumber != 2 {
+		t.Fatalf("unexpected second page: %+v", page)
+	}
+
+	if _, err := decoder.Next(); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+
+	if decoder.Producer() == nil || *decoder.Producer() != "poppler" {
+		t.Fatalf("expected producer poppler, got %v", decoder.Producer())
+	}
+	if decoder.Version() == nil || *decoder.Version() != "1.0" {
+		t.Fatalf("expected version 1.0, got %v", decoder.Version())
+	}
+	if len(decoder.Outlines()) != 1 {
+		t.Fatalf("expected 1 outline, got %d", len(decoder.Outlines()))
+	}
+}
+
+func TestStreamPagesStopsOnCallbackError(t *testing.T) {
+	wantErr := io.ErrClosedPipe
+	seen := 0
+
+	err := StreamPages(strings.NewReader(decoderTestDoc), func(page PdfXmlPage) error {
+		seen++
+		return wantErr
+	})
+
+	if err != wantErr {
+		t.Fatalf("expected %v, got %v", wantErr, err)
+	}
+	if seen != 1 {
+		t.Fatalf("expected callback to stop after first page, got %d calls", seen)
+	}
+}
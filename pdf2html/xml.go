@@ -57,6 +57,7 @@ type PdfXmlText struct {
 	Left   *int `xml:"left,attr"`
 	Width  *int `xml:"width,attr"`
 	Height *int `xml:"height,attr"`
+	Font   *int `xml:"font,attr,omitempty"`
 
 	Text     *string `xml:",chardata"`
 	BoldText *string `xml:"b"`
@@ -66,24 +67,36 @@ type PdfXmlTableOption struct {
 	From, To int // In what area should the table be located
 
 	Columns               int // How many columns should the table have
-	GetColumnFunc         func(text PdfXmlText) (int, error)
+	GetColumnFunc         func(text PdfXmlText) (column int, span int, err error)
 	AllowedHeightVariance int // Define what variance is allowed to be in the same line
 
 	FilterFunc *func(entry PdfXmlTableEntry) bool // function to filter entries, if set and return true, entry will be added to the result
+
+	MultiLine    bool // If enabled, a line whose AnchorColumn is empty is treated as the wrapped continuation of the previous row instead of a row of its own
+	AnchorColumn int  // Column inspected by MultiLine to detect whether a line starts a new row
+
+	// SameLineFunc overrides how two texts are decided to belong to the same row, e.g. to treat
+	// a bold heading in a different font size as a section break that flushes the current row.
+	// If nil, a text joins the current row only if both its Top and its baseline (Top shifted down
+	// by the larger of Height and font size) are at most AllowedHeightVariance below the row's first text's.
+	SameLineFunc func(prev, next PdfXmlText, prevFont, nextFont *PdfXmlFontSpec) bool
 }
 
 type PdfXmlTableEntry struct {
 	MinLeft, MaxLeft int // Entry's minimum and maximum position left
 	MinTop, MaxTop   int // Entry's minimum and maximum position top
 
-	top int // Internal fields for validating the same line functionality
+	anchor PdfXmlText // Internal field holding the first text of the row, used for same-line checks
 
-	Content []*PdfXmlTableEntryContent // the content in the text
+	Content []*PdfXmlTableEntryContent   // the content in the text, one entry per column
+	Lines   [][]*PdfXmlTableEntryContent // per-column wrapped line runs, only populated when PdfXmlTableOption.MultiLine is enabled
 }
 
 type PdfXmlTableEntryContent struct {
 	Text     *string // Normal text of the entry
 	BoldText *string // surrounded with <b> tags text
+
+	ColSpan int // Number of consecutive columns this entry spans, e.g. for a merged header cell. 0 behaves the same as 1.
 }
 
 type GetColumnCalculationInRangesOption struct {
@@ -99,9 +112,9 @@ func (p PdfXmlPage) ExtractTableContent(option PdfXmlTableOption) []*PdfXmlTable
 	table := []*PdfXmlTableEntry{}
 	for _, text := range texts {
 		var entry *PdfXmlTableEntry
-		if len(table) == 0 || !table[len(table)-1].isSameLine(text, option.AllowedHeightVariance) {
+		if len(table) == 0 || !p.isSameLine(*table[len(table)-1], text, option) {
 			entry = &PdfXmlTableEntry{
-				top: *text.Top,
+				anchor: text,
 
 				MinLeft: maxInt,
 				MaxLeft: 0,
@@ -113,7 +126,7 @@ func (p PdfXmlPage) ExtractTableContent(option PdfXmlTableOption) []*PdfXmlTable
 
 			// Reset internal variables
 			if len(table) != 0 {
-				table[len(table)-1].top = 0
+				table[len(table)-1].anchor = PdfXmlText{}
 			}
 
 			// check if old entry should stay or be removed through the filter func
@@ -133,14 +146,22 @@ func (p PdfXmlPage) ExtractTableContent(option PdfXmlTableOption) []*PdfXmlTable
 			entry = table[len(table)-1]
 		}
 
-		column, err := option.GetColumnFunc(text)
+		column, span, err := option.GetColumnFunc(text)
 		if err != nil {
 			continue
 		}
+		if span < 1 {
+			span = 1
+		}
 
-		entry.Content[column] = &PdfXmlTableEntryContent{
+		content := &PdfXmlTableEntryContent{
 			Text:     text.Text,
 			BoldText: text.BoldText,
+			ColSpan:  span,
+		}
+
+		for i := column; i < column+span && i < len(entry.Content); i++ {
+			entry.Content[i] = content
 		}
 
 		// Check for min/max
@@ -160,7 +181,7 @@ func (p PdfXmlPage) ExtractTableContent(option PdfXmlTableOption) []*PdfXmlTable
 
 	if len(table) != 0 {
 		// Reset internal variables
-		table[len(table)-1].top = 0
+		table[len(table)-1].anchor = PdfXmlText{}
 
 		// final check after last run through
 		if option.FilterFunc != nil {
@@ -174,9 +195,99 @@ func (p PdfXmlPage) ExtractTableContent(option PdfXmlTableOption) []*PdfXmlTable
 		}
 	}
 
+	if option.MultiLine {
+		table = mergeMultiLineRows(table, option.AnchorColumn)
+	}
+
 	return table
 }
 
+// textOrEmpty dereferences s, treating a nil pointer as an empty string instead of panicking.
+func textOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// contentText resolves the display text of a cell's content, preferring BoldText over Text and
+// treating a nil content or nil fields as an empty string — pdftohtml routinely emits <b>-only
+// fragments with no plain chardata.
+func contentText(content *PdfXmlTableEntryContent) string {
+	if content == nil {
+		return ""
+	}
+	if content.BoldText != nil {
+		return textOrEmpty(content.BoldText)
+	}
+
+	return textOrEmpty(content.Text)
+}
+
+// Merges consecutive rows whose AnchorColumn is empty into the row above, treating them as
+// wrapped continuations of the same logical row instead of rows of their own.
+func mergeMultiLineRows(table []*PdfXmlTableEntry, anchorColumn int) []*PdfXmlTableEntry {
+	merged := []*PdfXmlTableEntry{}
+
+	for _, entry := range table {
+		if len(merged) != 0 && (anchorColumn >= len(entry.Content) || entry.Content[anchorColumn] == nil) {
+			previous := merged[len(merged)-1]
+			previous.appendLines(entry)
+			continue
+		}
+
+		merged = append(merged, entry)
+	}
+
+	return merged
+}
+
+// appendLines merges next's content into e, column by column, joining wrapped text with "\n".
+func (e *PdfXmlTableEntry) appendLines(next *PdfXmlTableEntry) {
+	if e.Lines == nil {
+		e.Lines = make([][]*PdfXmlTableEntryContent, len(e.Content))
+		for column, content := range e.Content {
+			if content != nil {
+				e.Lines[column] = []*PdfXmlTableEntryContent{content}
+			}
+		}
+	}
+
+	for column, content := range next.Content {
+		if content == nil {
+			continue
+		}
+
+		e.Lines[column] = append(e.Lines[column], content)
+
+		if e.Content[column] == nil {
+			e.Content[column] = content
+		} else {
+			// BoldText is dropped here: it marks a single bold run, and a merged cell is no
+			// longer one - carrying it forward would make contentText (which prefers BoldText
+			// over Text) render only the first line and silently discard every continuation line.
+			mergedText := contentText(e.Content[column]) + "\n" + contentText(content)
+			e.Content[column] = &PdfXmlTableEntryContent{
+				Text: &mergedText,
+			}
+		}
+	}
+
+	if e.MinLeft > next.MinLeft {
+		e.MinLeft = next.MinLeft
+	}
+	if e.MaxLeft < next.MaxLeft {
+		e.MaxLeft = next.MaxLeft
+	}
+	if e.MinTop > next.MinTop {
+		e.MinTop = next.MinTop
+	}
+	if e.MaxTop < next.MaxTop {
+		e.MaxTop = next.MaxTop
+	}
+}
+
 // Provides a function upon variances around starting points the column matching
 func GetColumnCalculationWithVariance(columnAveragePosition []int, allowedVariance int) func(text PdfXmlText) (int, error) {
 	rangeOptions := []GetColumnCalculationInRangesOption{}
@@ -203,8 +314,92 @@ func GetColumnCalculationInRanges(columnRanges []GetColumnCalculationInRangesOpt
 	}
 }
 
-func (e PdfXmlTableEntry) isSameLine(text PdfXmlText, variance int) bool {
-	return (*text.Top - e.top) <= variance
+// GetColumnFuncWithSpan adapts a legacy (int, error) column func, such as one returned by
+// GetColumnCalculationWithVariance or GetColumnCalculationInRanges, to the (column, span, error)
+// signature expected by PdfXmlTableOption.GetColumnFunc. The resulting column never spans.
+func GetColumnFuncWithSpan(fn func(text PdfXmlText) (int, error)) func(text PdfXmlText) (int, int, error) {
+	return func(text PdfXmlText) (int, int, error) {
+		column, err := fn(text)
+		return column, 1, err
+	}
+}
+
+// GetColumnCalculationInRangesWithSpan is the span-aware counterpart of GetColumnCalculationInRanges:
+// it additionally detects merged cells by checking whether a text's Width reaches past the start of
+// one or more following ranges by more than allowedOverlap, e.g. a "Qty / Unit" header spanning two columns.
+func GetColumnCalculationInRangesWithSpan(columnRanges []GetColumnCalculationInRangesOption, allowedOverlap int) func(text PdfXmlText) (int, int, error) {
+	return func(text PdfXmlText) (int, int, error) {
+		for i, r := range columnRanges {
+			if *text.Left < r.From || *text.Left > r.To {
+				continue
+			}
+
+			right := *text.Left + *text.Width
+			span := 1
+			for i+span < len(columnRanges) && right > columnRanges[i+span].From+allowedOverlap {
+				span++
+			}
+
+			return i, span, nil
+		}
+
+		return -1, 0, fmt.Errorf("cannot find correct column")
+	}
+}
+
+// isSameLine decides whether text belongs on the same row as entry's anchor text. It defers to
+// option.SameLineFunc when set; otherwise text joins the row only if neither its raw Top nor its
+// baseline (see baseline) lies more than AllowedHeightVariance below the anchor's. Requiring raw
+// Top keeps a row with an unusually tall font (and thus an inflated baseline) from silently
+// absorbing an entirely separate, later row. Because both checks must pass, the baseline check
+// can only split texts that the raw Top check would group; it never groups texts whose Tops
+// differ by more than AllowedHeightVariance, so a row mixing e.g. a 14pt heading with 10pt body
+// text stays one row only if their Tops are within AllowedHeightVariance.
+func (p PdfXmlPage) isSameLine(entry PdfXmlTableEntry, text PdfXmlText, option PdfXmlTableOption) bool {
+	if option.SameLineFunc != nil {
+		return option.SameLineFunc(entry.anchor, text, p.fontOf(entry.anchor), p.fontOf(text))
+	}
+
+	topDiff := *text.Top - *entry.anchor.Top
+	baselineDiff := p.baseline(text) - p.baseline(entry.anchor)
+
+	return topDiff <= option.AllowedHeightVariance && baselineDiff <= option.AllowedHeightVariance
+}
+
+// Font looks up the font spec referenced by id, returning nil if the page has none with that id.
+func (p PdfXmlPage) Font(id int) *PdfXmlFontSpec {
+	for i := range p.FontSpecs {
+		if p.FontSpecs[i].ID != nil && *p.FontSpecs[i].ID == id {
+			return &p.FontSpecs[i]
+		}
+	}
+
+	return nil
+}
+
+// fontOf resolves the font spec a text references, if any.
+func (p PdfXmlPage) fontOf(text PdfXmlText) *PdfXmlFontSpec {
+	if text.Font == nil {
+		return nil
+	}
+
+	return p.Font(*text.Font)
+}
+
+// baseline computes where a text's visual baseline sits by shifting Top down by the larger of
+// its own Height or its font spec's Size, since pdftohtml sometimes leaves Height at the line
+// box height while the font spec carries the actual rendered size.
+func (p PdfXmlPage) baseline(text PdfXmlText) int {
+	height := 0
+	if text.Height != nil {
+		height = *text.Height
+	}
+
+	if spec := p.fontOf(text); spec != nil && spec.Size != nil && *spec.Size > height {
+		height = *spec.Size
+	}
+
+	return *text.Top + height
 }
 
 func (p PdfXmlPage) getSortedTexts(from, to int) []PdfXmlText {
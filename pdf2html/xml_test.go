@@ -0,0 +1,153 @@
+package pdf2html
+
+import "testing"
+
+func intPtr(v int) *int       { return &v }
+func strPtr(v string) *string { return &v }
+
+func newText(top, left, width, height int, text string) PdfXmlText {
+	return PdfXmlText{
+		Top:    intPtr(top),
+		Left:   intPtr(left),
+		Width:  intPtr(width),
+		Height: intPtr(height),
+		Text:   strPtr(text),
+	}
+}
+
+func rangeColumns(ranges ...GetColumnCalculationInRangesOption) func(PdfXmlText) (int, int, error) {
+	return GetColumnFuncWithSpan(GetColumnCalculationInRanges(ranges))
+}
+
+func TestExtractTableContentMultiLineBoldOnlyFragment(t *testing.T) {
+	page := PdfXmlPage{
+		Texts: []PdfXmlText{
+			newText(10, 0, 20, 10, "1"),
+			newText(10, 50, 20, 10, "first line"),
+			{Top: intPtr(25), Left: intPtr(50), Width: intPtr(20), Height: intPtr(10), BoldText: strPtr("bold-only")},
+		},
+	}
+
+	entries := page.ExtractTableContent(PdfXmlTableOption{
+		From: 0, To: 100,
+		Columns:               2,
+		GetColumnFunc:         rangeColumns(GetColumnCalculationInRangesOption{From: 0, To: 40}, GetColumnCalculationInRangesOption{From: 41, To: 100}),
+		AllowedHeightVariance: 0,
+		MultiLine:             true,
+		AnchorColumn:          0,
+	})
+
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 merged row, got %d", len(entries))
+	}
+
+	want := "first line\nbold-only"
+	if entries[0].Content[1] == nil || entries[0].Content[1].Text == nil || *entries[0].Content[1].Text != want {
+		t.Fatalf("expected merged cell %q, got %+v", want, entries[0].Content[1])
+	}
+}
+
+func TestExtractTableContentMultiLineBoldFirstLine(t *testing.T) {
+	page := PdfXmlPage{
+		Texts: []PdfXmlText{
+			newText(10, 0, 20, 10, "1"),
+			{Top: intPtr(10), Left: intPtr(50), Width: intPtr(20), Height: intPtr(10), BoldText: strPtr("bold first line")},
+			newText(25, 50, 20, 10, "second line"),
+		},
+	}
+
+	entries := page.ExtractTableContent(PdfXmlTableOption{
+		From: 0, To: 100,
+		Columns:               2,
+		GetColumnFunc:         rangeColumns(GetColumnCalculationInRangesOption{From: 0, To: 40}, GetColumnCalculationInRangesOption{From: 41, To: 100}),
+		AllowedHeightVariance: 0,
+		MultiLine:             true,
+		AnchorColumn:          0,
+	})
+
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 merged row, got %d", len(entries))
+	}
+
+	want := "bold first line\nsecond line"
+	if entries[0].Content[1] == nil || entries[0].Content[1].Text == nil || *entries[0].Content[1].Text != want {
+		t.Fatalf("expected merged cell %q, got %+v", want, entries[0].Content[1])
+	}
+}
+
+func TestIsSameLineDoesNotAbsorbDistinctRowAcrossInflatedBaseline(t *testing.T) {
+	page := PdfXmlPage{
+		FontSpecs: []PdfXmlFontSpec{
+			{ID: intPtr(0), Size: intPtr(30)},
+			{ID: intPtr(1), Size: intPtr(10)},
+		},
+		Texts: []PdfXmlText{
+			{Top: intPtr(100), Left: intPtr(0), Width: intPtr(100), Height: intPtr(10), Font: intPtr(0), Text: strPtr("HEADING")},
+			{Top: intPtr(140), Left: intPtr(0), Width: intPtr(100), Height: intPtr(10), Font: intPtr(1), Text: strPtr("TOTAL SECTION")},
+			{Top: intPtr(180), Left: intPtr(0), Width: intPtr(100), Height: intPtr(10), Font: intPtr(1), Text: strPtr("NEXT ROW")},
+		},
+	}
+
+	entries := page.ExtractTableContent(PdfXmlTableOption{
+		From: 0, To: 200,
+		Columns:               1,
+		GetColumnFunc:         rangeColumns(GetColumnCalculationInRangesOption{From: 0, To: 100}),
+		AllowedHeightVariance: 25,
+	})
+
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 distinct rows, got %d", len(entries))
+	}
+
+	if entries[1].Content[0] == nil || entries[1].Content[0].Text == nil || *entries[1].Content[0].Text != "TOTAL SECTION" {
+		t.Fatalf("expected second row to keep its own content, got %+v", entries[1].Content[0])
+	}
+}
+
+func TestGetColumnCalculationInRangesWithSpanDetectsColspan(t *testing.T) {
+	fn := GetColumnCalculationInRangesWithSpan([]GetColumnCalculationInRangesOption{
+		{From: 0, To: 40},
+		{From: 41, To: 80},
+		{From: 81, To: 120},
+	}, 2)
+
+	column, span, err := fn(PdfXmlText{Top: intPtr(0), Left: intPtr(5), Width: intPtr(70)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if column != 0 || span != 2 {
+		t.Fatalf("expected column 0 spanning 2, got column %d span %d", column, span)
+	}
+}
+
+func TestExtractTableContentFillsSpannedColumnsWithSamePointer(t *testing.T) {
+	page := PdfXmlPage{
+		Texts: []PdfXmlText{
+			{Top: intPtr(0), Left: intPtr(5), Width: intPtr(70), Height: intPtr(10), Text: strPtr("Qty / Unit")},
+			{Top: intPtr(0), Left: intPtr(90), Width: intPtr(20), Height: intPtr(10), Text: strPtr("3rd")},
+		},
+	}
+
+	entries := page.ExtractTableContent(PdfXmlTableOption{
+		From: 0, To: 10,
+		Columns: 3,
+		GetColumnFunc: GetColumnCalculationInRangesWithSpan([]GetColumnCalculationInRangesOption{
+			{From: 0, To: 40},
+			{From: 41, To: 80},
+			{From: 81, To: 120},
+		}, 2),
+		AllowedHeightVariance: 0,
+	})
+
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 row, got %d", len(entries))
+	}
+
+	entry := entries[0]
+	if entry.Content[0] != entry.Content[1] {
+		t.Fatalf("expected spanned columns to share the same content pointer")
+	}
+	if entry.Content[0].ColSpan != 2 {
+		t.Fatalf("expected ColSpan 2, got %d", entry.Content[0].ColSpan)
+	}
+}
@@ -0,0 +1,45 @@
+package render
+
+import (
+	"encoding/csv"
+	"io"
+
+	"github.com/nextunit-io/go-pdf2text/pdf2html"
+)
+
+// RenderCSV writes entries as CSV to w, including the header and footer rows from opt if set.
+func RenderCSV(w io.Writer, entries []*pdf2html.PdfXmlTableEntry, opt RenderOptions) error {
+	columns := columnCount(opt, entries)
+	writer := csv.NewWriter(w)
+
+	if len(opt.Header) > 0 {
+		if err := writer.Write(opt.Header); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range entries {
+		row := make([]string, columns)
+		for col := range row {
+			row[col] = cellText(opt, col, entry)
+		}
+
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	if opt.Footer != nil {
+		row := make([]string, columns)
+		for col := range row {
+			row[col] = opt.Footer(col, entries)
+		}
+
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
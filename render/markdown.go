@@ -0,0 +1,86 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nextunit-io/go-pdf2text/pdf2html"
+)
+
+// RenderMarkdown writes entries as a GitHub-flavored Markdown table to w, including the header
+// and footer rows from opt if set. Cells whose BoldText is set are wrapped in "**" when
+// opt.PreserveBold is enabled.
+func RenderMarkdown(w io.Writer, entries []*pdf2html.PdfXmlTableEntry, opt RenderOptions) error {
+	columns := columnCount(opt, entries)
+
+	writeRow := func(cells []string) error {
+		escaped := make([]string, len(cells))
+		for i, cell := range cells {
+			escaped[i] = escapeMarkdownCell(cell)
+		}
+
+		_, err := fmt.Fprintf(w, "| %s |\n", strings.Join(escaped, " | "))
+		return err
+	}
+
+	header := opt.Header
+	if len(header) == 0 {
+		header = make([]string, columns)
+	}
+	if err := writeRow(header); err != nil {
+		return err
+	}
+
+	separator := make([]string, columns)
+	for i := range separator {
+		separator[i] = "---"
+	}
+	if err := writeRow(separator); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		row := make([]string, columns)
+		for col := range row {
+			row[col] = markdownCell(opt, col, entry)
+		}
+
+		if err := writeRow(row); err != nil {
+			return err
+		}
+	}
+
+	if opt.Footer != nil {
+		row := make([]string, columns)
+		for col := range row {
+			row[col] = opt.Footer(col, entries)
+		}
+
+		if err := writeRow(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func markdownCell(opt RenderOptions, col int, entry *pdf2html.PdfXmlTableEntry) string {
+	text := cellText(opt, col, entry)
+
+	if opt.PreserveBold && !isSpanContinuation(col, entry) && col < len(entry.Content) && entry.Content[col] != nil && entry.Content[col].BoldText != nil {
+		return "**" + text + "**"
+	}
+
+	return text
+}
+
+// escapeMarkdownCell neutralizes characters that would otherwise corrupt a Markdown table:
+// a literal "|" would inject a phantom column boundary, and a "\n" (e.g. from a MultiLine
+// wrapped cell) would split one logical row across multiple physical lines.
+func escapeMarkdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	s = strings.ReplaceAll(s, "\n", "<br>")
+
+	return s
+}
@@ -0,0 +1,85 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/nextunit-io/go-pdf2text/pdf2html"
+)
+
+const ansiBold = "\x1b[1m%s\x1b[0m"
+
+// RenderPretty builds a github.com/jedib0t/go-pretty/v6/table.Writer from entries, with the
+// header and footer rows from opt if set, a right-aligned column for any column whose cells all
+// parse as numeric, and ANSI bold escapes for BoldText cells when opt.PreserveBold is enabled.
+// The caller is free to tweak the returned Writer's style before calling Render.
+func RenderPretty(entries []*pdf2html.PdfXmlTableEntry, opt RenderOptions) table.Writer {
+	columns := columnCount(opt, entries)
+	writer := table.NewWriter()
+
+	if len(opt.Header) > 0 {
+		writer.AppendHeader(toRow(opt.Header))
+	}
+
+	numeric := make([]bool, columns)
+	for col := range numeric {
+		numeric[col] = true
+	}
+
+	rows := make([][]string, len(entries))
+	for i, entry := range entries {
+		row := make([]string, columns)
+		for col := range row {
+			row[col] = prettyCell(opt, col, entry)
+			if numeric[col] && !isNumeric(cellText(opt, col, entry)) {
+				numeric[col] = false
+			}
+		}
+
+		rows[i] = row
+	}
+
+	for _, row := range rows {
+		writer.AppendRow(toRow(row))
+	}
+
+	if opt.Footer != nil {
+		row := make([]string, columns)
+		for col := range row {
+			row[col] = opt.Footer(col, entries)
+		}
+
+		writer.AppendFooter(toRow(row))
+	}
+
+	configs := make([]table.ColumnConfig, 0, columns)
+	for col := range numeric {
+		if numeric[col] {
+			configs = append(configs, table.ColumnConfig{Number: col + 1, Align: text.AlignRight})
+		}
+	}
+	writer.SetColumnConfigs(configs)
+
+	return writer
+}
+
+func prettyCell(opt RenderOptions, col int, entry *pdf2html.PdfXmlTableEntry) string {
+	text := cellText(opt, col, entry)
+
+	if opt.PreserveBold && !isSpanContinuation(col, entry) && col < len(entry.Content) && entry.Content[col] != nil && entry.Content[col].BoldText != nil {
+		return fmt.Sprintf(ansiBold, text)
+	}
+
+	return text
+}
+
+// toRow adapts a plain string slice to a go-pretty table.Row.
+func toRow(cells []string) table.Row {
+	row := make(table.Row, len(cells))
+	for i, cell := range cells {
+		row[i] = cell
+	}
+
+	return row
+}
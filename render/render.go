@@ -0,0 +1,93 @@
+// Package render turns the table entries returned by pdf2html.PdfXmlPage.ExtractTableContent
+// into ready-to-use output formats (CSV, Markdown, a github.com/jedib0t/go-pretty table), so
+// callers don't each have to re-implement the same serialization on top of PdfXmlTableEntry.
+package render
+
+import (
+	"strconv"
+
+	"github.com/nextunit-io/go-pdf2text/pdf2html"
+)
+
+// RenderOptions configures how table entries are turned into a concrete output format.
+type RenderOptions struct {
+	Header []string // optional header row, one label per column
+
+	// ColumnFormatter overrides how a cell is turned into text. If nil, the cell's BoldText
+	// (if set) or otherwise its Text is used, defaulting to an empty string for a nil cell.
+	ColumnFormatter func(col int, c *pdf2html.PdfXmlTableEntryContent) string
+
+	// Footer, if set, produces an aggregated footer row from the full entry set, one value per column.
+	Footer func(col int, entries []*pdf2html.PdfXmlTableEntry) string
+
+	// PreserveBold emits **bold** markers in Markdown and ANSI bold escapes in the pretty table
+	// for cells whose BoldText is set. Ignored by RenderCSV.
+	PreserveBold bool
+}
+
+// cellText resolves the display text of the content at column col in entry, honouring
+// RenderOptions.ColumnFormatter. A column that is merely the continuation of a colspan cell
+// from an earlier column renders as empty instead of repeating that cell's text.
+func cellText(opt RenderOptions, col int, entry *pdf2html.PdfXmlTableEntry) string {
+	if isSpanContinuation(col, entry) {
+		return ""
+	}
+
+	var content *pdf2html.PdfXmlTableEntryContent
+	if col < len(entry.Content) {
+		content = entry.Content[col]
+	}
+
+	if opt.ColumnFormatter != nil {
+		return opt.ColumnFormatter(col, content)
+	}
+
+	if content == nil {
+		return ""
+	}
+
+	if content.BoldText != nil {
+		return *content.BoldText
+	}
+	if content.Text != nil {
+		return *content.Text
+	}
+
+	return ""
+}
+
+// isSpanContinuation reports whether col holds the same content pointer as the column before
+// it, which is how ExtractTableContent marks the columns a ColSpan cell covers beyond the first.
+func isSpanContinuation(col int, entry *pdf2html.PdfXmlTableEntry) bool {
+	if col <= 0 || col >= len(entry.Content) {
+		return false
+	}
+
+	return entry.Content[col] != nil && entry.Content[col] == entry.Content[col-1]
+}
+
+// isNumeric reports whether s parses as a number, used to decide per-column alignment.
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
+
+// columnCount returns the number of columns to render, preferring the explicit header length.
+func columnCount(opt RenderOptions, entries []*pdf2html.PdfXmlTableEntry) int {
+	if len(opt.Header) > 0 {
+		return len(opt.Header)
+	}
+
+	columns := 0
+	for _, entry := range entries {
+		if len(entry.Content) > columns {
+			columns = len(entry.Content)
+		}
+	}
+
+	return columns
+}
@@ -0,0 +1,75 @@
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/nextunit-io/go-pdf2text/pdf2html"
+)
+
+func strPtr(s string) *string { return &s }
+
+func spannedEntry() *pdf2html.PdfXmlTableEntry {
+	shared := &pdf2html.PdfXmlTableEntryContent{Text: strPtr("Qty / Unit"), ColSpan: 2}
+
+	return &pdf2html.PdfXmlTableEntry{
+		Content: []*pdf2html.PdfXmlTableEntryContent{
+			shared,
+			shared,
+			{Text: strPtr("3rd")},
+		},
+	}
+}
+
+func TestRenderCSVBlanksSpanContinuation(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := RenderCSV(&buf, []*pdf2html.PdfXmlTableEntry{spannedEntry()}, RenderOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "Qty / Unit,,3rd\n"
+	if buf.String() != want {
+		t.Fatalf("expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestRenderMarkdownEscapesPipeAndNewline(t *testing.T) {
+	entry := &pdf2html.PdfXmlTableEntry{
+		Content: []*pdf2html.PdfXmlTableEntryContent{
+			{Text: strPtr("line one\nline two")},
+			{Text: strPtr("a|b")},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := RenderMarkdown(&buf, []*pdf2html.PdfXmlTableEntry{entry}, RenderOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header + separator + 1 data row, got %d lines: %q", len(lines), buf.String())
+	}
+
+	dataRow := lines[2]
+	want := `| line one<br>line two | a\|b |`
+	if dataRow != want {
+		t.Fatalf("expected %q, got %q", want, dataRow)
+	}
+}
+
+func TestPrettyCellBlanksSpanContinuation(t *testing.T) {
+	entry := spannedEntry()
+
+	if got := prettyCell(RenderOptions{}, 0, entry); got != "Qty / Unit" {
+		t.Fatalf("expected first spanned column to render text, got %q", got)
+	}
+	if got := prettyCell(RenderOptions{}, 1, entry); got != "" {
+		t.Fatalf("expected span continuation column to render empty, got %q", got)
+	}
+	if got := prettyCell(RenderOptions{}, 2, entry); got != "3rd" {
+		t.Fatalf("expected unrelated column unaffected, got %q", got)
+	}
+}